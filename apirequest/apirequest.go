@@ -2,6 +2,7 @@ package apirequest
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
 	cr "crypto/rand"
 	"crypto/sha256"
@@ -10,12 +11,18 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"math/rand"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"os"
 	"runtime"
+	"strconv"
+	"sync"
 	"time"
 
-	"github.com/moon-planet/solapi-go/types"
+	"golang.org/x/time/rate"
 )
 
 const sdkVersion string = "GO-SDK v1.0"
@@ -43,6 +50,71 @@ type APIRequest struct {
 
 	// Custom Config
 	Config map[string]string
+
+	// RetryPolicy controls automatic retries performed by RequestCtx and
+	// GETCtx. The zero value disables retries (a single attempt).
+	RetryPolicy RetryPolicy
+
+	// Limiter, when set, is waited on before every request so concurrent
+	// callers sharing this APIRequest self-throttle. It is installed
+	// automatically the first time the server responds 429, and can also
+	// be set ahead of time via SetLimiter.
+	Limiter *rate.Limiter
+
+	limiterMu sync.Mutex
+
+	// httpClient is the client used for every request. Set via
+	// SetHTTPClient; defaults to one backed by http.DefaultTransport with
+	// normal TLS verification.
+	httpClient *http.Client
+
+	// Logger receives debug tracing and error reporting. Defaults to a
+	// no-op; set NewStdLogger or your own implementation to capture it.
+	Logger Logger
+
+	// debug holds the channels enabled via SOLAPI_DEBUG (e.g. "sdk",
+	// "signer", "request").
+	debug map[string]bool
+}
+
+// RetryPolicy configures automatic retry behaviour for RequestCtx and
+// GETCtx. Network errors are always retried; HTTP responses are retried
+// only when their status code is listed in RetryOn, or, when RetryOn is
+// nil, when it is 429 or 5xx.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first.
+	// Values <= 1 disable retries.
+	MaxAttempts int
+
+	// InitialBackoff is the base delay before the first retry. Defaults to
+	// 200ms when zero.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the delay between retries. Defaults to 5s when zero.
+	MaxBackoff time.Duration
+
+	// Jitter enables decorrelated jitter between retries (see
+	// https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/)
+	// to avoid synchronized retry storms from concurrent callers.
+	Jitter bool
+
+	// RetryOn lists HTTP status codes that should trigger a retry. When
+	// left nil, responses are classified the same way APIError.IsRateLimit
+	// and APIError.IsServer do (429 and 5xx).
+	RetryOn []int
+}
+
+// DefaultRetryPolicy makes up to 3 attempts in all (the first plus 2
+// retries) for 429, 500, 502, 503 and 504 responses, with jittered
+// exponential backoff between 200ms and 5s.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Jitter:         true,
+		RetryOn:        []int{http.StatusTooManyRequests, 500, 502, 503, 504},
+	}
 }
 
 // RandomString returns a random string
@@ -59,7 +131,16 @@ func NewAPIRequest() *APIRequest {
 	goVersion := runtime.Version()
 	osPlatform := fmt.Sprintf("%s/%s", goos, goVersion)
 
-	request := APIRequest{response: "", statusCode: "", OsPlatform: osPlatform, SdkVersion: sdkVersion}
+	request := APIRequest{
+		response:    "",
+		statusCode:  "",
+		OsPlatform:  osPlatform,
+		SdkVersion:  sdkVersion,
+		RetryPolicy: DefaultRetryPolicy(),
+		httpClient:  &http.Client{},
+		Logger:      noopLogger{},
+		debug:       debugChannels(),
+	}
 
 	request.APIKey = os.Getenv("SOLAPI_API_KEY")
 	request.APISecret = os.Getenv("SOLAPI_API_SECRET")
@@ -97,6 +178,38 @@ func (a *APIRequest) SetCustomConfig(config map[string]string) error {
 	return nil
 }
 
+// client returns the *http.Client used for requests, falling back to a
+// plain http.Client (proper TLS verification, http.DefaultTransport) if one
+// hasn't been set yet.
+func (a *APIRequest) client() *http.Client {
+	if a.httpClient == nil {
+		a.httpClient = &http.Client{}
+	}
+	return a.httpClient
+}
+
+// SetHTTPClient overrides the *http.Client used for all requests made by a.
+// Use this to inject a custom transport, for example to route through a
+// corporate proxy, add mTLS, or wrap RoundTrip with tracing.
+func (a *APIRequest) SetHTTPClient(c *http.Client) {
+	a.httpClient = c
+}
+
+// SetInsecureSkipVerify opts into skipping TLS certificate verification by
+// replacing the client used for requests with a new one whose transport
+// sets InsecureSkipVerify to skip. This disables a core security protection
+// and should only be used against trusted test endpoints; a call with skip
+// set to true logs a warning. A client set earlier with SetHTTPClient is
+// replaced as well.
+func (a *APIRequest) SetInsecureSkipVerify(skip bool) {
+	if skip {
+		a.logger().Warnf("TLS certificate verification disabled via SetInsecureSkipVerify(true)")
+	}
+	a.httpClient = &http.Client{
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: skip}},
+	}
+}
+
 // GetAuthorization gets the authorization
 func (a *APIRequest) GetAuthorization() string {
 	salt := RandomString(20)
@@ -105,100 +218,476 @@ func (a *APIRequest) GetAuthorization() string {
 	h.Write([]byte(date + salt))
 	signature := hex.EncodeToString(h.Sum(nil))
 	authorization := fmt.Sprintf("HMAC-SHA256 apiKey=%s, date=%s, salt=%s, signature=%s", a.APIKey, date, salt, signature)
+
+	if a.debugEnabled("signer") {
+		a.logger().Debugf("signer: date=%s salt=%s signature=%s", date, salt, signature)
+	}
+
 	return authorization
 }
 
-// GET method request
+// GET method request using context.Background(). Kept for backward
+// compatibility; prefer GETCtx in new code.
 func (a *APIRequest) GET(resource string, params map[string]string, customStruct interface{}) error {
-	// Prepare for Http Request
-	tr := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
-	client := &http.Client{Transport: tr}
-	url := fmt.Sprintf("%s://%s/%s%s", a.Protocol, a.Domain, a.Prefix, resource)
-	req, _ := http.NewRequest("GET", url, nil)
+	return a.GETCtx(context.Background(), resource, params, customStruct)
+}
 
-	// Set Query Parameters
-	query := req.URL.Query()
-	for key, value := range params {
-		query.Add(key, value)
-	}
-	req.URL.RawQuery = query.Encode()
+// GETCtx performs a GET request against resource, retrying according to
+// a.RetryPolicy and waiting on a.Limiter (if set) before every attempt.
+func (a *APIRequest) GETCtx(ctx context.Context, resource string, params map[string]string, customStruct interface{}) error {
+	newReq := func() (*http.Request, error) {
+		url := fmt.Sprintf("%s://%s/%s%s", a.Protocol, a.Domain, a.Prefix, resource)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		query := req.URL.Query()
+		for key, value := range params {
+			query.Add(key, value)
+		}
+		req.URL.RawQuery = query.Encode()
 
-	// Set Headers
-	authorization := a.GetAuthorization()
-	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("Authorization", authorization)
+		req.Header.Add("Content-Type", "application/json")
+		req.Header.Add("Authorization", a.GetAuthorization())
+		return req, nil
+	}
 
-	// Request
-	resp, err := client.Do(req)
+	resp, err := a.doWithRetry(ctx, newReq)
 	if err != nil {
-		fmt.Println(err)
-		return errFailedToClientRequest
+		return err
 	}
+	defer resp.Body.Close()
 
 	// StatusCode가 200이 아니라면 에러로 처리
 	if resp.StatusCode != 200 {
-		errorStruct := types.CustomError{}
-		err = json.NewDecoder(resp.Body).Decode(&errorStruct)
+		apiErr, err := newAPIError(resp)
 		if err != nil {
 			return err
 		}
-		errString := fmt.Sprintf("%s[%d]:%s", errorStruct.ErrorCode, resp.StatusCode, errorStruct.ErrorMessage)
-		return errors.New(errString)
+		return apiErr
 	}
 
-	err = json.NewDecoder(resp.Body).Decode(&customStruct)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-	return nil
+	return json.NewDecoder(resp.Body).Decode(customStruct)
 }
 
-// Request method request
+// Request method request using context.Background(). Kept for backward
+// compatibility; prefer RequestCtx in new code.
 func (a *APIRequest) Request(method string, resource string, params interface{}, customStruct interface{}) error {
+	return a.RequestCtx(context.Background(), method, resource, params, customStruct)
+}
+
+// RequestCtx performs method against resource, retrying according to
+// a.RetryPolicy and waiting on a.Limiter (if set) before every attempt.
+func (a *APIRequest) RequestCtx(ctx context.Context, method string, resource string, params interface{}, customStruct interface{}) error {
 	// Convert to json string
 	jsonString, err := json.Marshal(params)
 	if err != nil {
-		fmt.Println(err)
+		a.logger().Errorf("failed to convert params to JSON: %v", err)
 		return errFailedToConvertJSON
 	}
 
-	// Prepare for Http Request
-	tr := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
-	client := &http.Client{Transport: tr}
-	url := fmt.Sprintf("%s://%s/%s%s", a.Protocol, a.Domain, a.Prefix, resource)
-	req, _ := http.NewRequest(method, url, bytes.NewBuffer(jsonString))
+	newReq := func() (*http.Request, error) {
+		url := fmt.Sprintf("%s://%s/%s%s", a.Protocol, a.Domain, a.Prefix, resource)
+		req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(jsonString))
+		if err != nil {
+			return nil, err
+		}
 
-	// Set Headers
-	authorization := a.GetAuthorization()
-	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("Authorization", authorization)
+		req.Header.Add("Content-Type", "application/json")
+		req.Header.Add("Authorization", a.GetAuthorization())
+		return req, nil
+	}
 
-	// Request
-	resp, err := client.Do(req)
+	resp, err := a.doWithRetry(ctx, newReq)
 	if err != nil {
-		fmt.Println(err)
-		return errFailedToClientRequest
+		return err
 	}
+	defer resp.Body.Close()
 
 	// StatusCode가 200이 아니라면 에러로 처리
 	if resp.StatusCode != 200 {
-		errorStruct := types.CustomError{}
-		err = json.NewDecoder(resp.Body).Decode(&errorStruct)
+		apiErr, err := newAPIError(resp)
 		if err != nil {
 			return err
 		}
-		errString := fmt.Sprintf("%s[%d]:%s", errorStruct.ErrorCode, resp.StatusCode, errorStruct.ErrorMessage)
-		return errors.New(errString)
+		return apiErr
+	}
+
+	return json.NewDecoder(resp.Body).Decode(customStruct)
+}
+
+const (
+	defaultInitialBackoff = 200 * time.Millisecond
+	defaultMaxBackoff     = 5 * time.Second
+)
+
+// doWithRetry executes the request built by newReq, retrying according to
+// a.RetryPolicy and waiting on a.Limiter (if set) before every attempt. A
+// Retry-After response header is honored in place of the computed backoff.
+// On a 429 response it also installs a conservative Limiter on a if one is
+// not already set, so subsequent callers sharing this APIRequest throttle
+// themselves automatically.
+func (a *APIRequest) doWithRetry(ctx context.Context, newReq func() (*http.Request, error)) (*http.Response, error) {
+	client := a.client()
+
+	attempts := a.RetryPolicy.MaxAttempts
+	if attempts < 1 {
+		attempts = 1
 	}
 
-	err = json.NewDecoder(resp.Body).Decode(&customStruct)
+	var lastErr error
+	var backoff time.Duration
+
+	for attempt := 0; attempt < attempts; attempt++ {
+		if limiter := a.getLimiter(); limiter != nil {
+			if err := limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		req, err := newReq()
+		if err != nil {
+			return nil, err
+		}
+		a.traceRequest(req)
+
+		resp, err := client.Do(req)
+		if err != nil {
+			lastErr = err
+			if !a.shouldRetry(attempt, attempts, 0) {
+				a.logger().Errorf("request failed: %v", err)
+				return nil, errFailedToClientRequest
+			}
+			backoff = a.nextBackoff(backoff)
+			if err := sleepCtx(ctx, backoff); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		a.traceResponse(resp)
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			a.throttle()
+		}
+
+		if !a.shouldRetry(attempt, attempts, resp.StatusCode) {
+			return resp, nil
+		}
+
+		lastErr = fmt.Errorf("request failed with status %d", resp.StatusCode)
+		wait := retryAfter(resp.Header)
+		resp.Body.Close()
+		if wait <= 0 {
+			backoff = a.nextBackoff(backoff)
+			wait = backoff
+		}
+		if err := sleepCtx(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, lastErr
+}
+
+// traceRequest logs req's method, URL, and headers (with Authorization
+// redacted) when the "request" debug channel is enabled.
+func (a *APIRequest) traceRequest(req *http.Request) {
+	if !a.debugEnabled("request") {
+		return
+	}
+	a.logger().Debugf("request: %s %s headers=%v", req.Method, req.URL, redactedHeaders(req.Header))
+}
+
+// traceResponse logs resp's status when the "request" debug channel is
+// enabled.
+func (a *APIRequest) traceResponse(resp *http.Response) {
+	if !a.debugEnabled("request") {
+		return
+	}
+	a.logger().Debugf("response: %s %s -> %s", resp.Request.Method, resp.Request.URL, resp.Status)
+}
+
+// shouldRetry reports whether a failed attempt should be retried. statusCode
+// of 0 means the request failed before a response was received (e.g. a
+// network error), which is always retried. When RetryPolicy.RetryOn is left
+// unset, it falls back to isRetryableStatus, the same rate-limit/server-error
+// classification APIError exposes via IsRateLimit and IsServer.
+func (a *APIRequest) shouldRetry(attempt, attempts, statusCode int) bool {
+	if attempt >= attempts-1 {
+		return false
+	}
+	if statusCode == 0 {
+		return true
+	}
+	if a.RetryPolicy.RetryOn == nil {
+		return isRetryableStatus(statusCode)
+	}
+	for _, code := range a.RetryPolicy.RetryOn {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// nextBackoff computes the delay before the next retry from prev, using
+// decorrelated jitter when a.RetryPolicy.Jitter is set and plain exponential
+// backoff otherwise.
+func (a *APIRequest) nextBackoff(prev time.Duration) time.Duration {
+	base := a.RetryPolicy.InitialBackoff
+	if base <= 0 {
+		base = defaultInitialBackoff
+	}
+	max := a.RetryPolicy.MaxBackoff
+	if max <= 0 {
+		max = defaultMaxBackoff
+	}
+	if prev <= 0 {
+		if !a.RetryPolicy.Jitter && base <= max {
+			return base
+		}
+		prev = base
+	}
+
+	if !a.RetryPolicy.Jitter {
+		next := prev * 2
+		if next > max {
+			next = max
+		}
+		return next
+	}
+
+	upper := prev * 3
+	if upper > max {
+		upper = max
+	}
+	if upper <= base {
+		return base
+	}
+	return base + time.Duration(rand.Int63n(int64(upper-base)))
+}
+
+// throttle installs a conservative Limiter on a the first time the server
+// signals it is rate limiting this APIRequest.
+func (a *APIRequest) throttle() {
+	a.limiterMu.Lock()
+	defer a.limiterMu.Unlock()
+	if a.Limiter == nil {
+		a.Limiter = rate.NewLimiter(rate.Limit(1), 1)
+	}
+}
+
+func (a *APIRequest) getLimiter() *rate.Limiter {
+	a.limiterMu.Lock()
+	defer a.limiterMu.Unlock()
+	return a.Limiter
+}
+
+// SetLimiter installs l as the rate limiter waited on before every request
+// made by a, overriding whatever throttle (if any) the server's 429
+// responses have installed so far.
+func (a *APIRequest) SetLimiter(l *rate.Limiter) {
+	a.limiterMu.Lock()
+	defer a.limiterMu.Unlock()
+	a.Limiter = l
+}
+
+// retryAfter parses a Retry-After response header, which may be either a
+// number of seconds or an HTTP date. It returns 0 when absent or invalid.
+func retryAfter(h http.Header) time.Duration {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
+
+// sleepCtx waits for d, returning early with ctx.Err() if ctx is canceled.
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// ProgressFunc reports streaming upload progress. total is 0 when the size
+// of the uploaded content is not known ahead of time.
+type ProgressFunc func(bytesSent, total int64)
+
+// UploadOptions configures a streaming multipart upload performed by Upload.
+type UploadOptions struct {
+	// ChunkSize is the number of bytes read from the source at a time
+	// before being written to the multipart body. Defaults to 64KB.
+	ChunkSize int64
+
+	// Total is the total size of the uploaded content, if known. It is
+	// only used to populate the total argument of Progress.
+	Total int64
+
+	// Progress, if set, is called after every chunk written to the body.
+	Progress ProgressFunc
+}
+
+const defaultUploadChunkSize int64 = 64 * 1024
+
+// Upload streams file as multipart/form-data to resource, using fieldName as
+// the form field holding the file part and params as the remaining form
+// fields. file must be an io.ReadSeeker because Upload reads it twice: once
+// to compute a SHA-256 checksum of the contents, sent as a real
+// X-Checksum-Sha256 header alongside the usual HMAC Authorization header
+// (trailers are unreliable across real HTTP servers and proxies), and again,
+// after seeking back to the start, to stream the body through an io.Pipe in
+// opts.ChunkSize chunks so the whole file never needs to be held in memory,
+// which matters for MMS/RCS attachments of more than a few MB.
+func (a *APIRequest) Upload(ctx context.Context, resource string, params map[string]string, fieldName string, filename string, contentType string, file io.ReadSeeker, opts UploadOptions, customStruct interface{}) error {
+	chunkSize := opts.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = defaultUploadChunkSize
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	checksum, err := hashReader(ctx, file, chunkSize)
 	if err != nil {
 		return err
 	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	if limiter := a.getLimiter(); limiter != nil {
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	url := fmt.Sprintf("%s://%s/%s%s", a.Protocol, a.Domain, a.Prefix, resource)
+	pr, pw := io.Pipe()
+	req, err := http.NewRequestWithContext(ctx, "POST", url, pr)
+	if err != nil {
+		return err
+	}
+
+	mw := multipart.NewWriter(pw)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	req.Header.Set("Authorization", a.GetAuthorization())
+	req.Header.Set("X-Checksum-Sha256", checksum)
+
+	writeErr := make(chan error, 1)
+	go func() {
+		err := writeMultipartBody(mw, params, fieldName, filename, contentType, file, chunkSize, opts)
+		pw.CloseWithError(err)
+		writeErr <- err
+	}()
+
+	a.traceRequest(req)
+	resp, err := a.client().Do(req)
+	if err != nil {
+		a.logger().Errorf("upload failed: %v", err)
+		return errFailedToClientRequest
+	}
+	a.traceResponse(resp)
 	defer resp.Body.Close()
 
-	return nil
+	if resp.StatusCode == http.StatusTooManyRequests {
+		a.throttle()
+	}
+
+	if resp.StatusCode != 200 {
+		// Drain the writer goroutine before returning; once the server has
+		// already responded, a write/pipe error is just the body no longer
+		// being read, not the real failure.
+		<-writeErr
+		apiErr, err := newAPIError(resp)
+		if err != nil {
+			return err
+		}
+		return apiErr
+	}
+
+	if werr := <-writeErr; werr != nil {
+		return werr
+	}
+
+	return json.NewDecoder(resp.Body).Decode(customStruct)
+}
+
+// hashReader returns the hex-encoded SHA-256 digest of r, read in chunkSize
+// increments from the current position. It checks ctx between chunks so a
+// cancellation takes effect during the checksum pass instead of only once
+// streaming starts.
+func hashReader(ctx context.Context, r io.Reader, chunkSize int64) (string, error) {
+	hash := sha256.New()
+	buf := make([]byte, chunkSize)
+	for {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		n, rerr := r.Read(buf)
+		if n > 0 {
+			hash.Write(buf[:n])
+		}
+		if rerr == io.EOF {
+			break
+		}
+		if rerr != nil {
+			return "", rerr
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// writeMultipartBody writes params and the file part into mw in chunkSize
+// increments, reporting progress through opts.Progress, then closes mw.
+func writeMultipartBody(mw *multipart.Writer, params map[string]string, fieldName, filename, contentType string, file io.Reader, chunkSize int64, opts UploadOptions) error {
+	for key, value := range params {
+		if err := mw.WriteField(key, value); err != nil {
+			return err
+		}
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name=%q; filename=%q`, fieldName, filename))
+	header.Set("Content-Type", contentType)
+	part, err := mw.CreatePart(header)
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, chunkSize)
+	var sent int64
+	for {
+		n, rerr := file.Read(buf)
+		if n > 0 {
+			if _, werr := part.Write(buf[:n]); werr != nil {
+				return werr
+			}
+			sent += int64(n)
+			if opts.Progress != nil {
+				opts.Progress(sent, opts.Total)
+			}
+		}
+		if rerr == io.EOF {
+			break
+		}
+		if rerr != nil {
+			return rerr
+		}
+	}
+
+	return mw.Close()
 }
 
 // POST method request
@@ -207,14 +696,29 @@ func (a *APIRequest) POST(resource string, params interface{}, customStruct inte
 	return err
 }
 
+// POSTCtx is the context-aware form of POST.
+func (a *APIRequest) POSTCtx(ctx context.Context, resource string, params interface{}, customStruct interface{}) error {
+	return a.RequestCtx(ctx, "POST", resource, params, &customStruct)
+}
+
 // PUT method request
 func (a *APIRequest) PUT(resource string, params interface{}, customStruct interface{}) error {
 	err := a.Request("PUT", resource, params, &customStruct)
 	return err
 }
 
+// PUTCtx is the context-aware form of PUT.
+func (a *APIRequest) PUTCtx(ctx context.Context, resource string, params interface{}, customStruct interface{}) error {
+	return a.RequestCtx(ctx, "PUT", resource, params, &customStruct)
+}
+
 // DELETE method request
 func (a *APIRequest) DELETE(resource string, params interface{}, customStruct interface{}) error {
 	err := a.Request("DELETE", resource, params, &customStruct)
 	return err
 }
+
+// DELETECtx is the context-aware form of DELETE.
+func (a *APIRequest) DELETECtx(ctx context.Context, resource string, params interface{}, customStruct interface{}) error {
+	return a.RequestCtx(ctx, "DELETE", resource, params, &customStruct)
+}
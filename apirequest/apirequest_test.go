@@ -0,0 +1,53 @@
+package apirequest
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextBackoffWithoutJitter(t *testing.T) {
+	a := &APIRequest{RetryPolicy: RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     1 * time.Second,
+	}}
+
+	prev := time.Duration(0)
+	for i := 0; i < 10; i++ {
+		next := a.nextBackoff(prev)
+		if next < a.RetryPolicy.InitialBackoff {
+			t.Fatalf("nextBackoff(%v) = %v, want >= %v", prev, next, a.RetryPolicy.InitialBackoff)
+		}
+		if next > a.RetryPolicy.MaxBackoff {
+			t.Fatalf("nextBackoff(%v) = %v, want <= %v", prev, next, a.RetryPolicy.MaxBackoff)
+		}
+		prev = next
+	}
+}
+
+func TestNextBackoffWithJitterStaysWithinBounds(t *testing.T) {
+	a := &APIRequest{RetryPolicy: RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     1 * time.Second,
+		Jitter:         true,
+	}}
+
+	prev := time.Duration(0)
+	for i := 0; i < 100; i++ {
+		next := a.nextBackoff(prev)
+		if next < a.RetryPolicy.InitialBackoff {
+			t.Fatalf("nextBackoff(%v) = %v, want >= %v", prev, next, a.RetryPolicy.InitialBackoff)
+		}
+		if next > a.RetryPolicy.MaxBackoff {
+			t.Fatalf("nextBackoff(%v) = %v, want <= %v", prev, next, a.RetryPolicy.MaxBackoff)
+		}
+		prev = next
+	}
+}
+
+func TestNextBackoffDefaultsWhenUnset(t *testing.T) {
+	a := &APIRequest{}
+	next := a.nextBackoff(0)
+	if next < defaultInitialBackoff || next > defaultMaxBackoff {
+		t.Fatalf("nextBackoff(0) = %v, want within [%v, %v]", next, defaultInitialBackoff, defaultMaxBackoff)
+	}
+}
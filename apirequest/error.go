@@ -0,0 +1,74 @@
+package apirequest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/moon-planet/solapi-go/types"
+)
+
+// APIError is the typed error returned when the SOLAPI API responds with a
+// non-200 status. It preserves the structured errorCode/errorMessage body
+// the server sent, the HTTP status, and the X-Request-Id response header,
+// so callers can branch on it with errors.As instead of parsing an error
+// string:
+//
+//	var apiErr *apirequest.APIError
+//	if errors.As(err, &apiErr) && apiErr.IsRateLimit() { ... }
+type APIError struct {
+	Code       string
+	Message    string
+	StatusCode int
+	RequestID  string
+}
+
+// Error implements error.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s[%d]:%s", e.Code, e.StatusCode, e.Message)
+}
+
+// IsRateLimit reports whether the request was rejected for hitting a rate
+// limit (HTTP 429 or errorCode "RateLimitExceeded").
+func (e *APIError) IsRateLimit() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.Code == "RateLimitExceeded"
+}
+
+// IsAuth reports whether the request failed authentication or
+// authorization (HTTP 401 or 403).
+func (e *APIError) IsAuth() bool {
+	return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+}
+
+// IsValidation reports whether the request body failed validation
+// (HTTP 400 or errorCode "ValidationError").
+func (e *APIError) IsValidation() bool {
+	return e.StatusCode == http.StatusBadRequest || e.Code == "ValidationError"
+}
+
+// IsServer reports whether the failure originated on the server (HTTP 5xx).
+func (e *APIError) IsServer() bool {
+	return e.StatusCode >= 500
+}
+
+// newAPIError decodes resp's CustomError body into an APIError, capturing
+// its HTTP status and request id.
+func newAPIError(resp *http.Response) (*APIError, error) {
+	errorStruct := types.CustomError{}
+	if err := json.NewDecoder(resp.Body).Decode(&errorStruct); err != nil {
+		return nil, err
+	}
+	return &APIError{
+		Code:       errorStruct.ErrorCode,
+		Message:    errorStruct.ErrorMessage,
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get("X-Request-Id"),
+	}, nil
+}
+
+// isRetryableStatus classifies statusCode the same way APIError.IsRateLimit
+// and APIError.IsServer do. It backs shouldRetry when RetryPolicy.RetryOn is
+// left unset.
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
@@ -0,0 +1,101 @@
+package apirequest
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Logger is the logging interface APIRequest uses for debug tracing and
+// error reporting. The default is a no-op, so nothing is logged unless a
+// Logger is set explicitly; SOLAPI_DEBUG only selects which trace lines are
+// sent to that Logger.
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Infof(format string, args ...interface{})
+	Warnf(format string, args ...interface{})
+	Errorf(format string, args ...interface{})
+}
+
+type noopLogger struct{}
+
+func (noopLogger) Debugf(string, ...interface{}) {}
+func (noopLogger) Infof(string, ...interface{})  {}
+func (noopLogger) Warnf(string, ...interface{})  {}
+func (noopLogger) Errorf(string, ...interface{}) {}
+
+// StdLogger is a Logger that writes leveled, prefixed lines to an
+// io.Writer.
+type StdLogger struct {
+	w io.Writer
+}
+
+// NewStdLogger returns a Logger that writes to w.
+func NewStdLogger(w io.Writer) *StdLogger {
+	return &StdLogger{w: w}
+}
+
+// Debugf implements Logger.
+func (l *StdLogger) Debugf(format string, args ...interface{}) { l.logf("DEBUG", format, args...) }
+
+// Infof implements Logger.
+func (l *StdLogger) Infof(format string, args ...interface{}) { l.logf("INFO", format, args...) }
+
+// Warnf implements Logger.
+func (l *StdLogger) Warnf(format string, args ...interface{}) { l.logf("WARN", format, args...) }
+
+// Errorf implements Logger.
+func (l *StdLogger) Errorf(format string, args ...interface{}) { l.logf("ERROR", format, args...) }
+
+func (l *StdLogger) logf(level, format string, args ...interface{}) {
+	fmt.Fprintf(l.w, "[solapi] %s %s\n", level, fmt.Sprintf(format, args...))
+}
+
+// Errorf logs through a's Logger (a no-op if one hasn't been set), for
+// packages that hold an *APIRequest but not a Logger of their own.
+func (a *APIRequest) Errorf(format string, args ...interface{}) {
+	a.logger().Errorf(format, args...)
+}
+
+// logger returns a.Logger, falling back to a no-op if it hasn't been set.
+func (a *APIRequest) logger() Logger {
+	if a.Logger == nil {
+		return noopLogger{}
+	}
+	return a.Logger
+}
+
+// debugChannels parses SOLAPI_DEBUG into the set of enabled trace channels,
+// e.g. "sdk,signer,request". "sdk" enables every channel.
+func debugChannels() map[string]bool {
+	channels := map[string]bool{}
+	for _, tok := range strings.Split(os.Getenv("SOLAPI_DEBUG"), ",") {
+		tok = strings.TrimSpace(tok)
+		if tok != "" {
+			channels[tok] = true
+		}
+	}
+	return channels
+}
+
+// debugEnabled reports whether channel tracing is active, either because it
+// was named explicitly in SOLAPI_DEBUG or because "sdk" was, which enables
+// all channels.
+func (a *APIRequest) debugEnabled(channel string) bool {
+	return a.debug["sdk"] || a.debug[channel]
+}
+
+// redactedHeaders returns a copy of h with the Authorization value masked,
+// suitable for request tracing.
+func redactedHeaders(h map[string][]string) map[string][]string {
+	redacted := make(map[string][]string, len(h))
+	for key, values := range h {
+		if strings.EqualFold(key, "Authorization") {
+			redacted[key] = []string{"[redacted]"}
+			continue
+		}
+		redacted[key] = values
+	}
+	return redacted
+}
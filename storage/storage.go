@@ -2,14 +2,16 @@ package storage
 
 import (
 	"bufio"
+	"context"
 	"encoding/base64"
 	"errors"
-	"fmt"
+	"io"
 	"io/ioutil"
+	"net/http"
 	"os"
 
-	"github.com/solapi/solapi-go/apirequest"
-	"github.com/solapi/solapi-go/types"
+	"github.com/moon-planet/solapi-go/apirequest"
+	"github.com/moon-planet/solapi-go/types"
 )
 
 var errFailToReadFile = errors.New("FailToReadFile")
@@ -21,6 +23,7 @@ type Storage struct{}
 // UploadFile upload a file
 func (r *Storage) UploadFile(params map[string]string) (types.File, error) {
 	result := types.File{}
+	request := apirequest.NewAPIRequest()
 
 	// 파일이 없다면 에러
 	if _, ok := params["file"]; !ok {
@@ -30,7 +33,7 @@ func (r *Storage) UploadFile(params map[string]string) (types.File, error) {
 	// Open file
 	f, err1 := os.Open(params["file"])
 	if err1 != nil {
-		fmt.Println(err1)
+		request.Errorf("failed to open file: %v", err1)
 		return result, errFileNotFound
 	}
 
@@ -38,7 +41,7 @@ func (r *Storage) UploadFile(params map[string]string) (types.File, error) {
 	reader := bufio.NewReader(f)
 	content, err2 := ioutil.ReadAll(reader)
 	if err2 != nil {
-		fmt.Println(err2)
+		request.Errorf("failed to read file: %v", err2)
 		return result, errFailToReadFile
 	}
 
@@ -48,7 +51,6 @@ func (r *Storage) UploadFile(params map[string]string) (types.File, error) {
 	// Print encoded data to params.
 	params["file"] = encoded
 
-	request := apirequest.NewAPIRequest()
 	err := request.POST("storage/v1/files", params, &result)
 	if err != nil {
 		return result, err
@@ -57,6 +59,57 @@ func (r *Storage) UploadFile(params map[string]string) (types.File, error) {
 	return result, nil
 }
 
+// UploadFileStream uploads a file as streaming multipart/form-data instead
+// of base64-encoding the whole payload into memory, which is required for
+// MMS/RCS attachments of more than a few MB. params["file"] is the file name
+// to send to the server; the actual bytes are read from file in chunks as
+// configured by opts. file must be an io.ReadSeeker because Upload reads it
+// twice (once to checksum, once to stream) and, on fallback, it is read a
+// third time.
+//
+// If the server rejects the multipart body, UploadFileStream rewinds file
+// and falls back to the legacy base64 JSON upload used by UploadFile.
+func (r *Storage) UploadFileStream(ctx context.Context, params map[string]string, file io.ReadSeeker, contentType string, opts apirequest.UploadOptions) (types.File, error) {
+	result := types.File{}
+
+	filename, ok := params["file"]
+	if !ok {
+		return result, errFileNotFound
+	}
+
+	request := apirequest.NewAPIRequest()
+	err := request.Upload(ctx, "storage/v1/files", params, "file", filename, contentType, file, opts, &result)
+	if err == nil {
+		return result, nil
+	}
+	if !isMultipartRejected(err) {
+		return result, err
+	}
+	if _, serr := file.Seek(0, io.SeekStart); serr != nil {
+		return result, err
+	}
+
+	content, rerr := ioutil.ReadAll(file)
+	if rerr != nil {
+		return result, rerr
+	}
+	params["file"] = base64.StdEncoding.EncodeToString(content)
+
+	fallback := types.File{}
+	if perr := request.POST("storage/v1/files", params, &fallback); perr != nil {
+		return result, perr
+	}
+	return fallback, nil
+}
+
+// isMultipartRejected reports whether err indicates the server does not
+// support the multipart/form-data upload path, so callers can fall back to
+// the legacy base64 JSON body.
+func isMultipartRejected(err error) bool {
+	var apiErr *apirequest.APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnsupportedMediaType
+}
+
 // GetFileList gets the list of files
 func (r *Storage) GetFileList(params map[string]string) (types.FileList, error) {
 	request := apirequest.NewAPIRequest()
@@ -68,3 +121,85 @@ func (r *Storage) GetFileList(params map[string]string) (types.FileList, error)
 
 	return result, nil
 }
+
+// FileListPage is a single page of results from a paginated storage/v1/files
+// request. Err is set instead of sending a partial page when the request
+// for this page failed, so a range loop over GetFileListAll can check it
+// and stop instead of acting on a zero-value page.
+//
+// The equivalent cursor iterators for Messages are not implemented here:
+// this tree has no messages package to hang them on (Client.Messages in
+// solapi.go refers to one that was never added), so there's nothing to
+// mirror this pattern onto yet.
+type FileListPage struct {
+	types.FileList
+	Err error
+}
+
+// GetFileListAll returns a channel that yields one FileListPage per page of
+// storage/v1/files results. It re-issues the request with the "startKey"
+// cursor carried in the previous page's NextKey until the server stops
+// returning one, closing the channel once the last page has been sent.
+// Cancel ctx to stop paging and release the backing goroutine if the caller
+// abandons the range before the channel is drained to completion.
+func (r *Storage) GetFileListAll(ctx context.Context, params map[string]string) <-chan FileListPage {
+	return r.iterateFileListPages(ctx, params)
+}
+
+// IterateFileList walks every file returned by storage/v1/files across all
+// pages, invoking fn for each one in order. It stops and returns fn's error
+// as soon as fn returns one, and returns ctx.Err() if ctx is canceled while
+// paging.
+func (r *Storage) IterateFileList(ctx context.Context, params map[string]string, fn func(types.File) error) error {
+	for page := range r.iterateFileListPages(ctx, params) {
+		if page.Err != nil {
+			return page.Err
+		}
+		for _, file := range page.Files {
+			if err := fn(file); err != nil {
+				return err
+			}
+		}
+	}
+	return ctx.Err()
+}
+
+// iterateFileListPages is the shared paging loop behind GetFileListAll and
+// IterateFileList.
+func (r *Storage) iterateFileListPages(ctx context.Context, params map[string]string) <-chan FileListPage {
+	ch := make(chan FileListPage)
+
+	cursorParams := make(map[string]string, len(params))
+	for key, value := range params {
+		cursorParams[key] = value
+	}
+
+	go func() {
+		defer close(ch)
+		request := apirequest.NewAPIRequest()
+
+		for {
+			page := types.FileList{}
+			if err := request.GETCtx(ctx, "storage/v1/files", cursorParams, &page); err != nil {
+				select {
+				case ch <- FileListPage{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			select {
+			case ch <- FileListPage{FileList: page}:
+			case <-ctx.Done():
+				return
+			}
+
+			if page.NextKey == "" {
+				return
+			}
+			cursorParams["startKey"] = page.NextKey
+		}
+	}()
+
+	return ch
+}
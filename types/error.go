@@ -0,0 +1,7 @@
+package types
+
+// CustomError is the error body the SOLAPI API sends on a non-200 response.
+type CustomError struct {
+	ErrorCode    string `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+}
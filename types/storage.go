@@ -0,0 +1,18 @@
+package types
+
+// File is a single file stored via the SOLAPI storage API, returned by
+// uploads and by FileList.
+type File struct {
+	FileID      string `json:"fileId"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Link        string `json:"link"`
+	DateCreated string `json:"dateCreated"`
+}
+
+// FileList is a page of storage/v1/files results. NextKey carries the
+// cursor for the next page, and is empty on the last page.
+type FileList struct {
+	Files   []File `json:"fileList"`
+	NextKey string `json:"nextKey"`
+}
@@ -0,0 +1,24 @@
+package types
+
+import "time"
+
+// MessageReportEvent is the payload of a SOLAPI delivery-report webhook for
+// a single message.
+type MessageReportEvent struct {
+	MessageID     string    `json:"messageId"`
+	GroupID       string    `json:"groupId"`
+	StatusCode    string    `json:"statusCode"`
+	StatusMessage string    `json:"statusMessage"`
+	ReportedAt    time.Time `json:"reportedAt"`
+}
+
+// GroupReportEvent is the payload of a SOLAPI delivery-report webhook for an
+// entire message group (a batch send).
+type GroupReportEvent struct {
+	GroupID     string    `json:"groupId"`
+	Status      string    `json:"status"`
+	Total       int       `json:"total"`
+	SentCount   int       `json:"sentCount"`
+	FailedCount int       `json:"failedCount"`
+	ReportedAt  time.Time `json:"reportedAt"`
+}
@@ -0,0 +1,181 @@
+// Package webhook verifies and decodes inbound SOLAPI delivery-report
+// callbacks, the counterpart to the HMAC signing apirequest.APIRequest does
+// for outbound requests.
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/moon-planet/solapi-go/types"
+)
+
+// DefaultMaxSkew is the maximum allowed difference between a webhook
+// request's signed date and the current time before Verify rejects it as a
+// possible replay.
+const DefaultMaxSkew = 5 * time.Minute
+
+var (
+	errMissingAuthorization   = errors.New("webhook: missing Authorization header")
+	errMalformedAuthorization = errors.New("webhook: malformed Authorization header")
+	errSignatureMismatch      = errors.New("webhook: signature mismatch")
+	errStaleTimestamp         = errors.New("webhook: timestamp outside allowed skew")
+)
+
+// Event is a decoded inbound SOLAPI webhook payload. Exactly one of
+// MessageReport or GroupReport is set, matching the "type" field in the
+// payload.
+type Event struct {
+	Type          string
+	MessageReport *types.MessageReportEvent
+	GroupReport   *types.GroupReportEvent
+}
+
+// Option configures Verify and Handler.
+type Option func(*config)
+
+type config struct {
+	maxSkew time.Duration
+}
+
+// WithMaxSkew overrides the default 5 minute replay-protection window.
+func WithMaxSkew(d time.Duration) Option {
+	return func(c *config) { c.maxSkew = d }
+}
+
+func newConfig(opts []Option) config {
+	cfg := config{maxSkew: DefaultMaxSkew}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// Verify reads r's body, recomputes its HMAC-SHA256 signature over
+// date + salt + body using secret, and compares it in constant time against
+// r's Authorization header. Requests whose date falls outside the allowed
+// skew (5 minutes by default, see WithMaxSkew) are rejected to guard
+// against replay. r.Body is restored after being read so callers can still
+// log or re-parse the raw payload.
+func Verify(secret string, r *http.Request, opts ...Option) (Event, error) {
+	cfg := newConfig(opts)
+
+	header := r.Header.Get("Authorization")
+	if header == "" {
+		return Event{}, errMissingAuthorization
+	}
+
+	date, salt, signature, err := parseAuthorization(header)
+	if err != nil {
+		return Event{}, err
+	}
+
+	sentAt, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		return Event{}, errMalformedAuthorization
+	}
+	if skew := time.Since(sentAt); skew < -cfg.maxSkew || skew > cfg.maxSkew {
+		return Event{}, errStaleTimestamp
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return Event{}, err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(date + salt))
+	h.Write(body)
+	expected := hex.EncodeToString(h.Sum(nil))
+
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return Event{}, errSignatureMismatch
+	}
+
+	return decodeEvent(body)
+}
+
+// Handler returns an http.Handler that verifies inbound requests with
+// secret, decodes them into an Event, and invokes onEvent. It responds 401
+// if verification fails and 500 if onEvent returns an error; otherwise 200.
+func Handler(secret string, onEvent func(Event) error, opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event, err := Verify(secret, r, opts...)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if err := onEvent(event); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// decodeEvent dispatches body to the MessageReportEvent or GroupReportEvent
+// shape based on its "type" field.
+func decodeEvent(body []byte) (Event, error) {
+	envelope := struct {
+		Type string `json:"type"`
+	}{}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return Event{}, err
+	}
+
+	event := Event{Type: envelope.Type}
+	switch envelope.Type {
+	case "MESSAGE_REPORT":
+		report := types.MessageReportEvent{}
+		if err := json.Unmarshal(body, &report); err != nil {
+			return Event{}, err
+		}
+		event.MessageReport = &report
+	case "GROUP_REPORT":
+		report := types.GroupReportEvent{}
+		if err := json.Unmarshal(body, &report); err != nil {
+			return Event{}, err
+		}
+		event.GroupReport = &report
+	default:
+		return Event{}, fmt.Errorf("webhook: unknown event type %q", envelope.Type)
+	}
+	return event, nil
+}
+
+// parseAuthorization extracts date/salt/signature from a header of the form
+// produced by apirequest.APIRequest.GetAuthorization:
+// "HMAC-SHA256 apiKey=X, date=Y, salt=Z, signature=W".
+func parseAuthorization(header string) (date, salt, signature string, err error) {
+	const prefix = "HMAC-SHA256 "
+	if !strings.HasPrefix(header, prefix) {
+		return "", "", "", errMalformedAuthorization
+	}
+
+	fields := map[string]string{}
+	for _, part := range strings.Split(strings.TrimPrefix(header, prefix), ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			return "", "", "", errMalformedAuthorization
+		}
+		fields[kv[0]] = kv[1]
+	}
+
+	date, okDate := fields["date"]
+	salt, okSalt := fields["salt"]
+	signature, okSig := fields["signature"]
+	if !okDate || !okSalt || !okSig {
+		return "", "", "", errMalformedAuthorization
+	}
+	return date, salt, signature, nil
+}
@@ -0,0 +1,83 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSecret = "test-secret"
+
+var testBody = []byte(`{"type":"MESSAGE_REPORT","messageId":"M1","groupId":"G1","statusCode":"2000","statusMessage":"ok","reportedAt":"2024-01-01T00:00:00Z"}`)
+
+func signedRequest(t *testing.T, sentAt time.Time, body []byte) *http.Request {
+	t.Helper()
+
+	date := sentAt.Format(time.RFC3339)
+	salt := "salt"
+	h := hmac.New(sha256.New, []byte(testSecret))
+	h.Write([]byte(date + salt))
+	h.Write(body)
+	signature := hex.EncodeToString(h.Sum(nil))
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(string(body)))
+	req.Header.Set("Authorization", "HMAC-SHA256 apiKey=k, date="+date+", salt="+salt+", signature="+signature)
+	return req
+}
+
+func TestVerifyWithinSkewSucceeds(t *testing.T) {
+	req := signedRequest(t, time.Now().Add(-DefaultMaxSkew+time.Second), testBody)
+
+	event, err := Verify(testSecret, req)
+	if err != nil {
+		t.Fatalf("Verify() error = %v, want nil", err)
+	}
+	if event.Type != "MESSAGE_REPORT" || event.MessageReport == nil {
+		t.Fatalf("Verify() event = %+v, want decoded MESSAGE_REPORT", event)
+	}
+}
+
+func TestVerifyOutsideSkewFails(t *testing.T) {
+	req := signedRequest(t, time.Now().Add(-DefaultMaxSkew-time.Second), testBody)
+
+	_, err := Verify(testSecret, req)
+	if !errors.Is(err, errStaleTimestamp) {
+		t.Fatalf("Verify() error = %v, want %v", err, errStaleTimestamp)
+	}
+}
+
+func TestVerifyFutureOutsideSkewFails(t *testing.T) {
+	req := signedRequest(t, time.Now().Add(DefaultMaxSkew+time.Second), testBody)
+
+	_, err := Verify(testSecret, req)
+	if !errors.Is(err, errStaleTimestamp) {
+		t.Fatalf("Verify() error = %v, want %v", err, errStaleTimestamp)
+	}
+}
+
+func TestVerifyCustomMaxSkew(t *testing.T) {
+	req := signedRequest(t, time.Now().Add(-2*time.Minute), testBody)
+
+	if _, err := Verify(testSecret, req, WithMaxSkew(1*time.Minute)); !errors.Is(err, errStaleTimestamp) {
+		t.Fatalf("Verify() error = %v, want %v", err, errStaleTimestamp)
+	}
+	if _, err := Verify(testSecret, req, WithMaxSkew(5*time.Minute)); err != nil {
+		t.Fatalf("Verify() error = %v, want nil", err)
+	}
+}
+
+func TestVerifyRejectsBadSignature(t *testing.T) {
+	req := signedRequest(t, time.Now(), testBody)
+	req2 := signedRequest(t, time.Now(), []byte(`{"type":"MESSAGE_REPORT"}`))
+	req.Header.Set("Authorization", req2.Header.Get("Authorization"))
+
+	if _, err := Verify(testSecret, req); !errors.Is(err, errSignatureMismatch) {
+		t.Fatalf("Verify() error = %v, want %v", err, errSignatureMismatch)
+	}
+}